@@ -0,0 +1,92 @@
+package main
+
+import (
+    "reflect"
+    "runtime/debug"
+    "testing"
+)
+
+func TestBuildInfoFromDebug(t *testing.T) {
+    bi := &debug.BuildInfo{
+        GoVersion: "go1.21.6",
+        Main: debug.Module{
+            Path:    "github.com/mkemalmayuk/codex_examples",
+            Version: "(devel)",
+        },
+        Settings: []debug.BuildSetting{
+            {Key: "GOARCH", Value: "amd64"},
+            {Key: "vcs.revision", Value: "deadbeef"},
+            {Key: "vcs.time", Value: "2026-01-01T00:00:00Z"},
+            {Key: "vcs.modified", Value: "true"},
+        },
+        Deps: []*debug.Module{
+            {Path: "gopkg.in/yaml.v3", Version: "v3.0.1", Sum: "h1:abc="},
+            {Path: "golang.org/x/sys", Version: "v0.18.0", Sum: "h1:def="},
+        },
+    }
+
+    want := BuildInfo{
+        GoVersion:     "go1.21.6",
+        ModulePath:    "github.com/mkemalmayuk/codex_examples",
+        ModuleVersion: "(devel)",
+        VCSRevision:   "deadbeef",
+        VCSTime:       "2026-01-01T00:00:00Z",
+        VCSModified:   true,
+        Dependencies: []Dependency{
+            {Path: "gopkg.in/yaml.v3", Version: "v3.0.1", Sum: "h1:abc="},
+            {Path: "golang.org/x/sys", Version: "v0.18.0", Sum: "h1:def="},
+        },
+    }
+
+    got := buildInfoFromDebug(bi)
+    if !reflect.DeepEqual(got, want) {
+        t.Errorf("buildInfoFromDebug() = %+v, want %+v", got, want)
+    }
+}
+
+func TestBuildInfoFromDebugIgnoresUnrelatedSettings(t *testing.T) {
+    bi := &debug.BuildInfo{
+        GoVersion: "go1.21.6",
+        Main:      debug.Module{Path: "example.com/m", Version: "v1.0.0"},
+        Settings: []debug.BuildSetting{
+            {Key: "GOOS", Value: "linux"},
+            {Key: "CGO_ENABLED", Value: "0"},
+        },
+    }
+
+    got := buildInfoFromDebug(bi)
+    if got.VCSRevision != "" || got.VCSTime != "" || got.VCSModified {
+        t.Errorf("buildInfoFromDebug() picked up non-vcs settings: %+v", got)
+    }
+    if len(got.Dependencies) != 0 {
+        t.Errorf("buildInfoFromDebug() with no deps = %+v, want empty Dependencies", got.Dependencies)
+    }
+}
+
+func TestReadBuildInfoNotOK(t *testing.T) {
+    orig := debugReadBuildInfo
+    defer func() { debugReadBuildInfo = orig }()
+
+    debugReadBuildInfo = func() (*debug.BuildInfo, bool) { return nil, false }
+
+    got := readBuildInfo()
+    if !reflect.DeepEqual(got, BuildInfo{}) {
+        t.Errorf("readBuildInfo() with ok=false = %+v, want zero value", got)
+    }
+}
+
+func TestCollectBuildInfoIsCached(t *testing.T) {
+    first := collectBuildInfo()
+
+    // Once cached, a changed source must not be re-read.
+    orig := debugReadBuildInfo
+    defer func() { debugReadBuildInfo = orig }()
+    debugReadBuildInfo = func() (*debug.BuildInfo, bool) {
+        return &debug.BuildInfo{GoVersion: "go0.0-changed"}, true
+    }
+
+    second := collectBuildInfo()
+    if !reflect.DeepEqual(first, second) {
+        t.Errorf("collectBuildInfo() returned different values across calls: %+v vs %+v", first, second)
+    }
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+    "net"
+    "os"
+    "runtime"
+
+    "github.com/mkemalmayuk/codex_examples/sysinfo"
+)
+
+// HostInfo is the structured representation of the facts this program
+// collects about the local host. Field order is fixed so that JSON/YAML
+// output stays stable across runs, which matters for tools that diff
+// successive snapshots.
+type HostInfo struct {
+    Hostname   string         `json:"hostname" yaml:"hostname"`
+    OSType     string         `json:"os_type" yaml:"os_type"`
+    OSName     string         `json:"os_name" yaml:"os_name"`
+    OSVersion  string         `json:"os_version" yaml:"os_version"`
+    OSBuild    string         `json:"os_build" yaml:"os_build"`
+    Interfaces []NetInterface `json:"interfaces" yaml:"interfaces"`
+    Build      BuildInfo      `json:"build" yaml:"build"`
+}
+
+// NetInterface describes one non-loopback network interface and the
+// addresses bound to it.
+type NetInterface struct {
+    Name      string      `json:"name" yaml:"name"`
+    MAC       string      `json:"mac" yaml:"mac"`
+    Flags     string      `json:"flags" yaml:"flags"`
+    MTU       int         `json:"mtu" yaml:"mtu"`
+    Addresses []IPAddress `json:"addresses" yaml:"addresses"`
+}
+
+// IPAddress describes a single address bound to a network interface.
+type IPAddress struct {
+    CIDR    string `json:"cidr" yaml:"cidr"`
+    Version int    `json:"version" yaml:"version"`
+    Scope   string `json:"scope" yaml:"scope"`
+}
+
+// addressFilter narrows which interfaces and address families
+// collectHostInfo reports. The zero value matches everything.
+type addressFilter struct {
+    V4    bool
+    V6    bool
+    Iface string
+}
+
+// collectHostInfo gathers hostname, OS, and non-loopback interface facts
+// into a HostInfo. Errors from individual sources are not fatal: the
+// corresponding fields are simply left empty.
+func collectHostInfo(filter addressFilter) (*HostInfo, error) {
+    info := &HostInfo{}
+
+    hostname, err := os.Hostname()
+    if err == nil {
+        info.Hostname = hostname
+    }
+
+    info.OSType = runtime.GOOS
+    if name, version, build, err := sysinfo.OSVersion(); err == nil {
+        info.OSName = name
+        info.OSVersion = version
+        info.OSBuild = build
+    }
+
+    info.Build = collectBuildInfo()
+
+    ifaces, err := net.Interfaces()
+    if err != nil {
+        return info, err
+    }
+
+    for _, iface := range ifaces {
+        if !includeInterface(iface, filter) {
+            continue
+        }
+
+        addrs, err := iface.Addrs()
+        if err != nil {
+            continue
+        }
+
+        netIface := NetInterface{
+            Name:  iface.Name,
+            MAC:   iface.HardwareAddr.String(),
+            Flags: iface.Flags.String(),
+            MTU:   iface.MTU,
+        }
+
+        for _, addr := range addrs {
+            ipNet, ok := addr.(*net.IPNet)
+            if !ok || ipNet.IP.IsLoopback() {
+                continue
+            }
+
+            version, ok := includeAddress(ipNet.IP, filter)
+            if !ok {
+                continue
+            }
+
+            netIface.Addresses = append(netIface.Addresses, IPAddress{
+                CIDR:    ipNet.String(),
+                Version: version,
+                Scope:   addressScope(ipNet.IP),
+            })
+        }
+
+        if len(netIface.Addresses) > 0 {
+            info.Interfaces = append(info.Interfaces, netIface)
+        }
+    }
+
+    return info, nil
+}
+
+// includeInterface reports whether iface should be considered at all:
+// it must be up, non-loopback, and (if filter.Iface is set) match by name.
+func includeInterface(iface net.Interface, filter addressFilter) bool {
+    if iface.Flags&net.FlagLoopback != 0 || iface.Flags&net.FlagUp == 0 {
+        return false
+    }
+    if filter.Iface != "" && iface.Name != filter.Iface {
+        return false
+    }
+    return true
+}
+
+// includeAddress reports whether ip passes filter's address-family
+// restriction, and if so, which IP version (4 or 6) it is. A filter with
+// both V4 and V6 false is unrestricted, per addressFilter's zero-value
+// contract; a caller wanting only one family must set the other to false
+// explicitly while leaving its wanted family true.
+func includeAddress(ip net.IP, filter addressFilter) (version int, ok bool) {
+    unrestricted := !filter.V4 && !filter.V6
+
+    isV4 := ip.To4() != nil
+    if isV4 {
+        return 4, unrestricted || filter.V4
+    }
+    return 6, unrestricted || filter.V6
+}
+
+// addressScope classifies an IP address as "link-local", "unique-local"
+// (IPv6 ULA), or "global".
+func addressScope(ip net.IP) string {
+    switch {
+    case ip.IsLinkLocalUnicast():
+        return "link-local"
+    case ip.To4() == nil && ip[0]&0xfe == 0xfc:
+        return "unique-local"
+    default:
+        return "global"
+    }
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+    "net"
+    "testing"
+)
+
+func TestAddressScope(t *testing.T) {
+    tests := []struct {
+        name string
+        ip   net.IP
+        want string
+    }{
+        {"IPv4 global", net.ParseIP("93.184.216.34"), "global"},
+        {"IPv4 link-local", net.ParseIP("169.254.1.1"), "link-local"},
+        {"IPv6 global", net.ParseIP("2001:db8::1"), "global"},
+        {"IPv6 link-local", net.ParseIP("fe80::1"), "link-local"},
+        {"IPv6 unique-local fc", net.ParseIP("fc00::1"), "unique-local"},
+        {"IPv6 unique-local fd", net.ParseIP("fd12:3456:789a::1"), "unique-local"},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := addressScope(tt.ip); got != tt.want {
+                t.Errorf("addressScope(%s) = %q, want %q", tt.ip, got, tt.want)
+            }
+        })
+    }
+}
+
+func TestIncludeInterface(t *testing.T) {
+    up := net.Interface{Name: "eth0", Flags: net.FlagUp}
+    down := net.Interface{Name: "eth1", Flags: 0}
+    loopback := net.Interface{Name: "lo", Flags: net.FlagUp | net.FlagLoopback}
+
+    tests := []struct {
+        name   string
+        iface  net.Interface
+        filter addressFilter
+        want   bool
+    }{
+        {"up interface, no name filter", up, addressFilter{}, true},
+        {"down interface excluded", down, addressFilter{}, false},
+        {"loopback excluded even if up", loopback, addressFilter{}, false},
+        {"name filter matches", up, addressFilter{Iface: "eth0"}, true},
+        {"name filter excludes others", up, addressFilter{Iface: "eth1"}, false},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            if got := includeInterface(tt.iface, tt.filter); got != tt.want {
+                t.Errorf("includeInterface(%+v, %+v) = %v, want %v", tt.iface, tt.filter, got, tt.want)
+            }
+        })
+    }
+}
+
+func TestIncludeAddress(t *testing.T) {
+    v4 := net.ParseIP("192.0.2.1")
+    v6 := net.ParseIP("2001:db8::1")
+
+    tests := []struct {
+        name        string
+        ip          net.IP
+        filter      addressFilter
+        wantVersion int
+        wantOK      bool
+    }{
+        {"v4 allowed", v4, addressFilter{V4: true, V6: true}, 4, true},
+        {"v4 filtered out", v4, addressFilter{V4: false, V6: true}, 4, false},
+        {"v6 allowed", v6, addressFilter{V4: true, V6: true}, 6, true},
+        {"v6 filtered out", v6, addressFilter{V4: true, V6: false}, 6, false},
+        {"zero value is unrestricted for v4", v4, addressFilter{}, 4, true},
+        {"zero value is unrestricted for v6", v6, addressFilter{}, 6, true},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            version, ok := includeAddress(tt.ip, tt.filter)
+            if version != tt.wantVersion || ok != tt.wantOK {
+                t.Errorf("includeAddress(%s, %+v) = (%d, %v), want (%d, %v)",
+                    tt.ip, tt.filter, version, ok, tt.wantVersion, tt.wantOK)
+            }
+        })
+    }
+}
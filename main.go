@@ -0,0 +1,112 @@
+package main
+
+import (
+    "encoding/json"
+    "flag"
+    "fmt"
+    "io"
+    "os"
+    "time"
+
+    "gopkg.in/yaml.v3"
+)
+
+func main() {
+    format := flag.String("format", "text", "output format: text, json, or yaml")
+    pretty := flag.Bool("pretty", false, "indent json output for readability (yaml output is always indented)")
+    serveAddr := flag.String("serve", "", "run as a long-lived agent, serving /facts and /metrics on this address (e.g. :8080)")
+    refresh := flag.Duration("refresh", 30*time.Second, "how often the -serve agent refreshes its collected facts")
+    only4 := flag.Bool("4", false, "only report IPv4 addresses")
+    only6 := flag.Bool("6", false, "only report IPv6 addresses")
+    ifaceName := flag.String("iface", "", "only report this interface")
+    flag.Parse()
+
+    filter := addressFilter{V4: true, V6: true, Iface: *ifaceName}
+    if *only4 && !*only6 {
+        filter.V6 = false
+    }
+    if *only6 && !*only4 {
+        filter.V4 = false
+    }
+
+    if *serveAddr != "" {
+        if err := serve(*serveAddr, *refresh, filter); err != nil {
+            fmt.Fprintf(os.Stderr, "error: %v\n", err)
+            os.Exit(1)
+        }
+        return
+    }
+
+    info, err := collectHostInfo(filter)
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+    }
+
+    switch *format {
+    case "text":
+        printText(os.Stdout, info)
+    case "json":
+        if err := printJSON(os.Stdout, info, *pretty); err != nil {
+            fmt.Fprintf(os.Stderr, "error: %v\n", err)
+            os.Exit(1)
+        }
+    case "yaml":
+        if err := printYAML(os.Stdout, info, *pretty); err != nil {
+            fmt.Fprintf(os.Stderr, "error: %v\n", err)
+            os.Exit(1)
+        }
+    default:
+        fmt.Fprintf(os.Stderr, "error: unknown format %q (want text, json, or yaml)\n", *format)
+        os.Exit(1)
+    }
+}
+
+func printText(w io.Writer, info *HostInfo) {
+    fmt.Fprintf(w, "Hostname: %s\n", info.Hostname)
+    for _, iface := range info.Interfaces {
+        fmt.Fprintf(w, "Interface: %s (mac=%s, mtu=%d, flags=%s)\n", iface.Name, iface.MAC, iface.MTU, iface.Flags)
+        for _, addr := range iface.Addresses {
+            fmt.Fprintf(w, "  IP Address: %s (v%d, %s)\n", addr.CIDR, addr.Version, addr.Scope)
+        }
+    }
+    fmt.Fprintf(w, "OS Type: %s\n", info.OSType)
+    fmt.Fprintf(w, "OS Name: %s\n", info.OSName)
+    fmt.Fprintf(w, "OS Version: %s\n", info.OSVersion)
+    fmt.Fprintf(w, "OS Build: %s\n", info.OSBuild)
+
+    build := info.Build
+    fmt.Fprintf(w, "Go Version: %s\n", build.GoVersion)
+    fmt.Fprintf(w, "Module: %s@%s\n", build.ModulePath, build.ModuleVersion)
+    fmt.Fprintf(w, "VCS Revision: %s (modified=%t, time=%s)\n", build.VCSRevision, build.VCSModified, build.VCSTime)
+    for _, dep := range build.Dependencies {
+        fmt.Fprintf(w, "  Dependency: %s@%s (%s)\n", dep.Path, dep.Version, dep.Sum)
+    }
+}
+
+func printJSON(w io.Writer, info *HostInfo, pretty bool) error {
+    var (
+        out []byte
+        err error
+    )
+    if pretty {
+        out, err = json.MarshalIndent(info, "", "  ")
+    } else {
+        out, err = json.Marshal(info)
+    }
+    if err != nil {
+        return err
+    }
+    fmt.Fprintln(w, string(out))
+    return nil
+}
+
+// printYAML ignores pretty: yaml.v3 always emits block-style, indented
+// output, so there is no compact form to opt out of.
+func printYAML(w io.Writer, info *HostInfo, pretty bool) error {
+    buf, err := yaml.Marshal(info)
+    if err != nil {
+        return err
+    }
+    fmt.Fprint(w, string(buf))
+    return nil
+}
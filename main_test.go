@@ -0,0 +1,124 @@
+package main
+
+import (
+    "bytes"
+    "strings"
+    "testing"
+)
+
+func testHostInfo() *HostInfo {
+    return &HostInfo{
+        Hostname:  "myhost",
+        OSType:    "linux",
+        OSName:    "Debian GNU/Linux",
+        OSVersion: "12",
+        OSBuild:   "6.1.0",
+        Interfaces: []NetInterface{
+            {
+                Name:  "eth0",
+                MAC:   "aa:bb:cc:dd:ee:ff",
+                Flags: "up|running",
+                MTU:   1500,
+                Addresses: []IPAddress{
+                    {CIDR: "192.0.2.1/24", Version: 4, Scope: "global"},
+                },
+            },
+        },
+        Build: BuildInfo{
+            GoVersion:     "go1.21.6",
+            ModulePath:    "github.com/mkemalmayuk/codex_examples",
+            ModuleVersion: "(devel)",
+            VCSRevision:   "deadbeef",
+            VCSTime:       "2026-01-01T00:00:00Z",
+            VCSModified:   true,
+            Dependencies: []Dependency{
+                {Path: "gopkg.in/yaml.v3", Version: "v3.0.1", Sum: "h1:abc="},
+            },
+        },
+    }
+}
+
+func TestPrintTextIncludesAllSections(t *testing.T) {
+    var buf bytes.Buffer
+    printText(&buf, testHostInfo())
+    out := buf.String()
+
+    for _, want := range []string{
+        "Hostname: myhost",
+        "Interface: eth0 (mac=aa:bb:cc:dd:ee:ff, mtu=1500, flags=up|running)",
+        "IP Address: 192.0.2.1/24 (v4, global)",
+        "OS Type: linux",
+        "Go Version: go1.21.6",
+        "Module: github.com/mkemalmayuk/codex_examples@(devel)",
+        "Dependency: gopkg.in/yaml.v3@v3.0.1 (h1:abc=)",
+    } {
+        if !strings.Contains(out, want) {
+            t.Errorf("printText output missing %q\ngot:\n%s", want, out)
+        }
+    }
+}
+
+func TestPrintJSONFieldOrder(t *testing.T) {
+    var buf bytes.Buffer
+    if err := printJSON(&buf, testHostInfo(), false); err != nil {
+        t.Fatalf("printJSON: %v", err)
+    }
+    out := buf.String()
+
+    // Field order must stay stable for downstream diffing, per HostInfo's
+    // doc comment: hostname, then OS fields, then interfaces, then build.
+    order := []string{
+        `"hostname"`,
+        `"os_type"`,
+        `"os_name"`,
+        `"os_version"`,
+        `"os_build"`,
+        `"interfaces"`,
+        `"build"`,
+    }
+    lastIdx := -1
+    for _, key := range order {
+        idx := strings.Index(out, key)
+        if idx == -1 {
+            t.Fatalf("printJSON output missing key %s\ngot:\n%s", key, out)
+        }
+        if idx < lastIdx {
+            t.Errorf("key %s appears out of order (at %d, previous key at %d)\ngot:\n%s", key, idx, lastIdx, out)
+        }
+        lastIdx = idx
+    }
+}
+
+func TestPrintJSONPretty(t *testing.T) {
+    var compact, pretty bytes.Buffer
+    if err := printJSON(&compact, testHostInfo(), false); err != nil {
+        t.Fatalf("printJSON(compact): %v", err)
+    }
+    if err := printJSON(&pretty, testHostInfo(), true); err != nil {
+        t.Fatalf("printJSON(pretty): %v", err)
+    }
+
+    if strings.Contains(compact.String(), "\n  ") {
+        t.Errorf("compact JSON should not be indented\ngot:\n%s", compact.String())
+    }
+    if !strings.Contains(pretty.String(), "\n  ") {
+        t.Errorf("pretty JSON should be indented\ngot:\n%s", pretty.String())
+    }
+    if compact.Len() == pretty.Len() {
+        t.Errorf("pretty output should differ in length from compact output")
+    }
+}
+
+func TestPrintYAML(t *testing.T) {
+    var buf bytes.Buffer
+    if err := printYAML(&buf, testHostInfo(), false); err != nil {
+        t.Fatalf("printYAML: %v", err)
+    }
+    out := buf.String()
+
+    for _, want := range []string{"hostname: myhost", "os_type: linux", "interfaces:", "build:"} {
+        if !strings.Contains(out, want) {
+            t.Errorf("printYAML output missing %q\ngot:\n%s", want, out)
+        }
+    }
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "os"
+    "strings"
+    "sync"
+    "time"
+)
+
+// factsServer holds the most recently collected HostInfo and refreshes it
+// on a timer so that /facts and /metrics never block on a live collection.
+type factsServer struct {
+    filter addressFilter
+
+    mu   sync.RWMutex
+    info *HostInfo
+}
+
+func newFactsServer(refresh time.Duration, filter addressFilter) (*factsServer, error) {
+    if refresh <= 0 {
+        return nil, fmt.Errorf("refresh interval must be positive, got %s", refresh)
+    }
+
+    s := &factsServer{filter: filter}
+    s.refresh()
+
+    go func() {
+        ticker := time.NewTicker(refresh)
+        defer ticker.Stop()
+        for range ticker.C {
+            s.refresh()
+        }
+    }()
+
+    return s, nil
+}
+
+func (s *factsServer) refresh() {
+    info, err := collectHostInfo(s.filter)
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "warning: refresh: %v\n", err)
+    }
+    s.mu.Lock()
+    s.info = info
+    s.mu.Unlock()
+}
+
+func (s *factsServer) current() *HostInfo {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    return s.info
+}
+
+func (s *factsServer) handleFacts(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("Content-Type", "application/json")
+    if err := json.NewEncoder(w).Encode(s.current()); err != nil {
+        http.Error(w, err.Error(), http.StatusInternalServerError)
+    }
+}
+
+func (s *factsServer) handleMetrics(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+    fmt.Fprint(w, renderMetrics(s.current()))
+}
+
+// renderMetrics builds the Prometheus text-format exposition for a
+// HostInfo snapshot. It is a pure function of its input so it can be
+// tested without a live server or real network interfaces.
+func renderMetrics(info *HostInfo) string {
+    var b strings.Builder
+
+    fmt.Fprintln(&b, "# HELP host_info Static host identity labels, always 1.")
+    fmt.Fprintln(&b, "# TYPE host_info gauge")
+    addrCount := 0
+    for _, iface := range info.Interfaces {
+        for _, addr := range iface.Addresses {
+            addrCount++
+            fmt.Fprintf(&b, "host_info{hostname=%q,os=%q,kernel=%q,ip=%q} 1\n",
+                info.Hostname, info.OSType, info.OSBuild, addr.CIDR)
+        }
+    }
+    if addrCount == 0 {
+        fmt.Fprintf(&b, "host_info{hostname=%q,os=%q,kernel=%q,ip=\"\"} 1\n",
+            info.Hostname, info.OSType, info.OSBuild)
+    }
+
+    // host_interfaces_with_address_total (not "host_interface_up"): this is
+    // a count, not a per-target 1/0 gauge, so it must not carry the _up
+    // suffix Prometheus convention reserves for per-target up/down state.
+    fmt.Fprintln(&b, "# HELP host_interfaces_with_address_total Number of distinct, non-loopback interfaces with at least one address.")
+    fmt.Fprintln(&b, "# TYPE host_interfaces_with_address_total gauge")
+    fmt.Fprintf(&b, "host_interfaces_with_address_total %d\n", len(info.Interfaces))
+
+    fmt.Fprintln(&b, "# HELP host_ip_addresses_total Number of non-loopback IP addresses bound to the host.")
+    fmt.Fprintln(&b, "# TYPE host_ip_addresses_total gauge")
+    fmt.Fprintf(&b, "host_ip_addresses_total %d\n", addrCount)
+
+    return b.String()
+}
+
+// serve starts the long-running HTTP agent and blocks until the server
+// exits, which normally only happens on error.
+func serve(addr string, refresh time.Duration, filter addressFilter) error {
+    s, err := newFactsServer(refresh, filter)
+    if err != nil {
+        return err
+    }
+
+    mux := http.NewServeMux()
+    mux.HandleFunc("/facts", s.handleFacts)
+    mux.HandleFunc("/metrics", s.handleMetrics)
+
+    fmt.Printf("serving host facts on %s (refresh every %s)\n", addr, refresh)
+    return http.ListenAndServe(addr, mux)
+}
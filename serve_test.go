@@ -0,0 +1,76 @@
+package main
+
+import (
+    "strings"
+    "testing"
+    "time"
+)
+
+func TestRenderMetrics(t *testing.T) {
+    info := &HostInfo{
+        Hostname:  "myhost",
+        OSType:    "linux",
+        OSVersion: "12",
+        OSBuild:   "6.1.0",
+        Interfaces: []NetInterface{
+            {
+                Name: "eth0",
+                Addresses: []IPAddress{
+                    {CIDR: "192.0.2.1/24", Version: 4, Scope: "global"},
+                    {CIDR: "2001:db8::1/64", Version: 6, Scope: "global"},
+                },
+            },
+        },
+    }
+
+    out := renderMetrics(info)
+
+    for _, want := range []string{
+        `host_info{hostname="myhost",os="linux",kernel="6.1.0",ip="192.0.2.1/24"} 1`,
+        `host_info{hostname="myhost",os="linux",kernel="6.1.0",ip="2001:db8::1/64"} 1`,
+        "host_interfaces_with_address_total 1",
+        "host_ip_addresses_total 2",
+    } {
+        if !strings.Contains(out, want) {
+            t.Errorf("renderMetrics output missing %q\ngot:\n%s", want, out)
+        }
+    }
+
+    if strings.Contains(out, "host_interface_up") {
+        t.Errorf("renderMetrics should not emit the misleading host_interface_up name\ngot:\n%s", out)
+    }
+}
+
+func TestRenderMetricsNoAddresses(t *testing.T) {
+    info := &HostInfo{Hostname: "myhost", OSType: "linux", OSVersion: "12", OSBuild: "6.1.0"}
+
+    out := renderMetrics(info)
+
+    if !strings.Contains(out, `host_info{hostname="myhost",os="linux",kernel="6.1.0",ip=""} 1`) {
+        t.Errorf("renderMetrics with no addresses should still emit one host_info sample\ngot:\n%s", out)
+    }
+    if !strings.Contains(out, "host_interfaces_with_address_total 0") {
+        t.Errorf("renderMetrics should report zero interfaces\ngot:\n%s", out)
+    }
+    if !strings.Contains(out, "host_ip_addresses_total 0") {
+        t.Errorf("renderMetrics should report zero addresses\ngot:\n%s", out)
+    }
+}
+
+func TestNewFactsServerRejectsNonPositiveRefresh(t *testing.T) {
+    for _, refresh := range []time.Duration{0, -1 * time.Second} {
+        if _, err := newFactsServer(refresh, addressFilter{V4: true, V6: true}); err == nil {
+            t.Errorf("newFactsServer(refresh=%s) = nil error, want an error", refresh)
+        }
+    }
+}
+
+func TestNewFactsServerAcceptsPositiveRefresh(t *testing.T) {
+    s, err := newFactsServer(time.Hour, addressFilter{V4: true, V6: true})
+    if err != nil {
+        t.Fatalf("newFactsServer: %v", err)
+    }
+    if s.current() == nil {
+        t.Error("current() = nil, want an initial HostInfo snapshot")
+    }
+}
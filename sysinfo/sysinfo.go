@@ -0,0 +1,10 @@
+// Package sysinfo provides cross-platform OS version detection without
+// shelling out to external commands like uname.
+package sysinfo
+
+// OSVersion reports the host operating system's name, version, and build
+// number. The underlying implementation is platform-specific; see the
+// sysinfo_linux.go, sysinfo_darwin.go, and sysinfo_windows.go files.
+func OSVersion() (name, version, build string, err error) {
+    return osVersion()
+}
@@ -0,0 +1,22 @@
+//go:build darwin
+
+package sysinfo
+
+import "syscall"
+
+// osVersion reads the kern.osrelease and kern.osproductversion sysctls to
+// report the Darwin kernel build and the marketing macOS version.
+func osVersion() (name, version, build string, err error) {
+    build, err = syscall.Sysctl("kern.osrelease")
+    if err != nil {
+        return "", "", "", err
+    }
+
+    version, err = syscall.Sysctl("kern.osproductversion")
+    if err != nil {
+        return "", "", "", err
+    }
+
+    name = "macOS"
+    return
+}
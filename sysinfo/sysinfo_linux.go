@@ -0,0 +1,58 @@
+//go:build linux
+
+package sysinfo
+
+import (
+    "bufio"
+    "os"
+    "strings"
+)
+
+// osVersion parses /etc/os-release for the distro name and version and
+// reads the kernel build string from /proc/sys/kernel/osrelease. When
+// os-release is unavailable or incomplete, name falls back to "Linux" and
+// version to the kernel build string.
+func osVersion() (name, version, build string, err error) {
+    name, version = parseOSRelease("/etc/os-release")
+
+    osrelease, readErr := os.ReadFile("/proc/sys/kernel/osrelease")
+    if readErr != nil {
+        err = readErr
+        return
+    }
+    build = strings.TrimSpace(string(osrelease))
+
+    if name == "" {
+        name = "Linux"
+    }
+    if version == "" {
+        version = build
+    }
+    return
+}
+
+// parseOSRelease reads NAME and VERSION_ID out of a file in os-release
+// format. Missing or unreadable files simply yield empty strings.
+func parseOSRelease(path string) (name, version string) {
+    f, err := os.Open(path)
+    if err != nil {
+        return "", ""
+    }
+    defer f.Close()
+
+    scanner := bufio.NewScanner(f)
+    for scanner.Scan() {
+        line := scanner.Text()
+        switch {
+        case strings.HasPrefix(line, "NAME="):
+            name = unquote(strings.TrimPrefix(line, "NAME="))
+        case strings.HasPrefix(line, "VERSION_ID="):
+            version = unquote(strings.TrimPrefix(line, "VERSION_ID="))
+        }
+    }
+    return
+}
+
+func unquote(s string) string {
+    return strings.Trim(s, `"`)
+}
@@ -0,0 +1,67 @@
+//go:build linux
+
+package sysinfo
+
+import (
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+func TestParseOSRelease(t *testing.T) {
+    tests := []struct {
+        name        string
+        contents    string
+        wantName    string
+        wantVersion string
+    }{
+        {
+            name:        "quoted fields",
+            contents:    "NAME=\"Debian GNU/Linux\"\nVERSION_ID=\"12\"\n",
+            wantName:    "Debian GNU/Linux",
+            wantVersion: "12",
+        },
+        {
+            name:        "unquoted fields",
+            contents:    "NAME=Arch Linux\nVERSION_ID=rolling\n",
+            wantName:    "Arch Linux",
+            wantVersion: "rolling",
+        },
+        {
+            name:        "missing version",
+            contents:    "NAME=\"Alpine Linux\"\n",
+            wantName:    "Alpine Linux",
+            wantVersion: "",
+        },
+        {
+            name:        "irrelevant keys ignored",
+            contents:    "PRETTY_NAME=\"ignored\"\nNAME=\"Ubuntu\"\nID=ubuntu\nVERSION_ID=\"24.04\"\n",
+            wantName:    "Ubuntu",
+            wantVersion: "24.04",
+        },
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            path := filepath.Join(t.TempDir(), "os-release")
+            if err := os.WriteFile(path, []byte(tt.contents), 0o644); err != nil {
+                t.Fatalf("WriteFile: %v", err)
+            }
+
+            name, version := parseOSRelease(path)
+            if name != tt.wantName {
+                t.Errorf("name = %q, want %q", name, tt.wantName)
+            }
+            if version != tt.wantVersion {
+                t.Errorf("version = %q, want %q", version, tt.wantVersion)
+            }
+        })
+    }
+}
+
+func TestParseOSReleaseMissingFile(t *testing.T) {
+    name, version := parseOSRelease("/nonexistent/os-release")
+    if name != "" || version != "" {
+        t.Errorf("parseOSRelease(missing) = (%q, %q), want (\"\", \"\")", name, version)
+    }
+}
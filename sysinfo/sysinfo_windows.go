@@ -0,0 +1,21 @@
+//go:build windows
+
+package sysinfo
+
+import (
+    "fmt"
+
+    "golang.org/x/sys/windows"
+)
+
+// osVersion calls RtlGetVersion directly, since the major/minor/build
+// numbers reported by the documented Windows version APIs are frozen at
+// the application manifest's declared compatibility level.
+func osVersion() (name, version, build string, err error) {
+    info := windows.RtlGetVersion()
+
+    name = "Windows"
+    version = fmt.Sprintf("%d.%d", info.MajorVersion, info.MinorVersion)
+    build = fmt.Sprintf("%d", info.BuildNumber)
+    return
+}